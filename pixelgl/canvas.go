@@ -6,6 +6,7 @@ import (
 
 	"github.com/faiface/glhf"
 	"github.com/faiface/mainthread"
+	"github.com/go-gl/gl/v3.3-core/gl"
 	"github.com/go-gl/mathgl/mgl32"
 	"github.com/pkg/errors"
 	"github.com/tango-rocker/pixel"
@@ -19,22 +20,65 @@ type Canvas struct {
 	gf     *GLFrame
 	shader *GLShader
 
-	cmp    pixel.ComposeMethod
-	mat    mgl32.Mat3
-	col    mgl32.Vec4
-	smooth bool
+	cmp      pixel.ComposeMethod
+	blend    pixel.Blend
+	mat      mgl32.Mat3
+	col      mgl32.Vec4
+	smooth   bool
+	fillRule pixel.FillRule
+
+	numAttachments int
+	stencilRBO     uint32
+
+	fragmentSrc string
+	shaderUnit  pixel.ShaderUnit
+
+	auxTextures [8]*glhf.Texture
+	auxBounds   [8]pixel.Rect
 
 	sprite *pixel.Sprite
 }
 
 var _ pixel.ComposeTarget = (*Canvas)(nil)
 
+// MaxAttachments is the maximum number of color attachments a Canvas created with NewCanvasMRT can
+// have, addressable as outColor0..outColor3 in a custom fragment shader.
+const MaxAttachments = 4
+
 // NewCanvas creates a new empty, fully transparent Canvas with given bounds.
 func NewCanvas(bounds pixel.Rect) *Canvas {
 	c := &Canvas{
-		gf:  NewGLFrame(bounds),
-		mat: mgl32.Ident3(),
-		col: mgl32.Vec4{1, 1, 1, 1},
+		gf:             NewGLFrame(bounds),
+		mat:            mgl32.Ident3(),
+		col:            mgl32.Vec4{1, 1, 1, 1},
+		blend:          composeBlend(pixel.ComposeOver),
+		numAttachments: 1,
+		fragmentSrc:    baseCanvasFragmentShader,
+	}
+
+	c.shader = NewGLShader(baseCanvasFragmentShader)
+	c.SetBounds(bounds)
+	return c
+}
+
+// NewCanvasMRT creates a new empty, fully transparent Canvas with given bounds and n color
+// attachments (1 to MaxAttachments).
+//
+// A custom fragment shader set with SetFragmentShader can write to the extra attachments through
+// outColor0..outColor{n-1}, and each attachment can later be sampled as an ordinary Picture via
+// Attachment.
+func NewCanvasMRT(bounds pixel.Rect, n int) *Canvas {
+	if n < 1 || n > MaxAttachments {
+		panic(errors.Errorf("pixelgl: NewCanvasMRT: n must be between 1 and %d, got %d", MaxAttachments, n))
+	}
+
+	c := &Canvas{
+		gf:             NewGLFrameMRT(bounds, n),
+		mat:            mgl32.Ident3(),
+		col:            mgl32.Vec4{1, 1, 1, 1},
+		blend:          composeBlend(pixel.ComposeOver),
+		numAttachments: n,
+		fragmentSrc:    baseCanvasFragmentShader,
 	}
 
 	c.shader = NewGLShader(baseCanvasFragmentShader)
@@ -42,6 +86,26 @@ func NewCanvas(bounds pixel.Rect) *Canvas {
 	return c
 }
 
+// Attachment returns the i-th color attachment (0 to Attachments()-1) of a Canvas created with
+// NewCanvasMRT as a regular Pixel Picture, so it can be sampled in a later draw pass.
+//
+// Attachment 0 is always the Canvas's own Texture; on a Canvas created with plain NewCanvas, 0 is
+// the only valid index.
+func (c *Canvas) Attachment(i int) pixel.Picture {
+	if i < 0 || i >= c.numAttachments {
+		panic(errors.Errorf("pixelgl: Canvas.Attachment: index %d out of range [0, %d)", i, c.numAttachments))
+	}
+	return &canvasAttachment{
+		tex:    c.gf.AttachmentTexture(i),
+		bounds: c.Bounds(),
+	}
+}
+
+// Attachments returns the number of color attachments this Canvas was created with.
+func (c *Canvas) Attachments() int {
+	return c.numAttachments
+}
+
 // SetUniform will update the named uniform with the value of any supported underlying
 // attribute variable. If the uniform already exists, including defaults, they will be reassigned
 // to the new value. The value can be a pointer.
@@ -52,10 +116,82 @@ func (c *Canvas) SetUniform(name string, value interface{}) {
 // SetFragmentShader allows you to set a new fragment shader on the underlying
 // framebuffer. Argument "src" is the GLSL source, not a filename.
 func (c *Canvas) SetFragmentShader(src string) {
+	c.fragmentSrc = src
+	c.applyFragmentShader()
+}
+
+// SetShaderUnit sets the coordinate space custom fragment shaders (set via SetFragmentShader) are
+// written in.
+//
+// pixel.ShaderUnitTexel (the default) is the raw normalized texel space OpenGL always works in.
+// pixel.ShaderUnitPixel injects a prelude exposing vec2 pixelCoord() (the current fragment's
+// pixel position in canvas space), vec4 imageSrcPixelAt(vec2 p) and vec2 imageSrcPixelSize(),
+// built on top of the existing u_bounds/u_texbounds uniforms, so post-processing kernels like box
+// blur or edge detect can be written in pixel coordinates instead of the /imageSrcTextureSize()
+// idiom.
+func (c *Canvas) SetShaderUnit(unit pixel.ShaderUnit) {
+	c.shaderUnit = unit
+	c.shader.unit = unit
+	c.applyFragmentShader()
+}
+
+// applyFragmentShader recompiles the Canvas's fragment shader from c.fragmentSrc, prepending
+// pixelUnitPrelude first when c.shaderUnit is pixel.ShaderUnitPixel. GLShader.Update only knows how
+// to compile whatever source c.shader.fs already holds, so the prelude is composed here rather than
+// there.
+func (c *Canvas) applyFragmentShader() {
+	src := c.fragmentSrc
+	if c.shaderUnit == pixel.ShaderUnitPixel {
+		src = pixelUnitPrelude + src
+	}
 	c.shader.fs = src
 	c.shader.Update()
 }
 
+// pixelUnitPrelude is prepended to the compiled fragment shader source when a Canvas's shader unit
+// is pixel.ShaderUnitPixel. u_bounds and u_texbounds are the same uniforms every Canvas shader
+// (custom or base) already declares and has fed into it every draw, so the prelude only adds
+// helper functions built on top of them, rather than redeclaring them itself.
+//
+// u_image, the primary texture sampler a custom shader samples via imageSrcPixelAt, is assumed to
+// be named u_image to match baseCanvasFragmentShader; if a future custom shader names its sampler
+// differently, imageSrcPixelAt needs updating to match.
+const pixelUnitPrelude = `
+vec2 pixelCoord() {
+	return gl_FragCoord.xy + u_bounds.xy;
+}
+
+vec2 imageSrcPixelSize() {
+	return u_texbounds.zw;
+}
+
+vec4 imageSrcPixelAt(vec2 p) {
+	return texture2D(u_image, (p + u_texbounds.xy) / u_texbounds.zw);
+}
+`
+
+// SetAuxTexture binds a Picture to one of this Canvas's auxiliary texture slots (1 to 7; slot 0 is
+// always the primary Picture/Triangles texture of the draw). A custom fragment shader set with
+// SetFragmentShader can then sample it through the auto-wired uniform sampler2D u_aux1..u_aux7,
+// alongside its own u_aux1_texbounds..u_aux7_texbounds for pixel-space sampling. Passing a nil
+// Picture clears the slot.
+func (c *Canvas) SetAuxTexture(slot int, p pixel.Picture) {
+	if slot < 1 || slot > 7 {
+		panic(errors.Errorf("pixelgl: Canvas.SetAuxTexture: slot must be between 1 and 7, got %d", slot))
+	}
+	if p == nil {
+		c.auxTextures[slot] = nil
+		return
+	}
+	gp := c.MakePicture(p).(*canvasPicture)
+	c.auxTextures[slot] = gp.GLPicture.Texture()
+	c.auxBounds[slot] = gp.GLPicture.Bounds()
+
+	// Tell a custom shader's sampler2D u_auxN which texture unit draw() actually bound it to
+	// (gl.TEXTURE0+slot); u_auxN_texbounds is fed separately, per draw, via uniformDefaults.
+	c.SetUniform(fmt.Sprintf("u_aux%d", slot), int32(slot))
+}
+
 // MakeTriangles creates a specialized copy of the supplied Triangles that draws onto this Canvas.
 //
 // TrianglesPosition, TrianglesColor and TrianglesPicture are supported.
@@ -121,14 +257,36 @@ func (c *Canvas) SetColorMask(col color.Color) {
 }
 
 // SetComposeMethod sets a Porter-Duff composition method to be used in the following draws onto
-// this Canvas.
+// this Canvas. It's a convenience over SetBlend, installing the preset Blend that implements cmp.
 func (c *Canvas) SetComposeMethod(cmp pixel.ComposeMethod) {
 	c.cmp = cmp
+	c.blend = composeBlend(cmp)
+}
+
+// SetBlend sets a custom Blend to be used in the following draws onto this Canvas, overriding
+// whatever preset SetComposeMethod installed. Use this for blend modes Porter-Duff composition
+// can't express, such as the GL_FUNC_SUBTRACT/GL_MIN/GL_MAX equations or constant-color blending.
+func (c *Canvas) SetBlend(blend pixel.Blend) {
+	c.blend = blend
+}
+
+// SetFillRule sets the fill rule used to rasterize triangles drawn onto this Canvas.
+//
+// pixel.FillRuleNonZero (the default) is the cheap, single-pass rule and is correct for
+// triangulations that don't overlap themselves. pixel.FillRuleEvenOdd switches to a two-pass
+// stencil fill, letting concave/self-intersecting polygons produced by stroke/fill builders
+// rasterize correctly without manual triangulation.
+func (c *Canvas) SetFillRule(rule pixel.FillRule) {
+	c.fillRule = rule
 }
 
 // SetBounds resizes the Canvas to the new bounds. Old content will be preserved.
+//
+// This also reallocates the Canvas's stencil buffer (used by the even-odd fill rule) to match
+// the new size.
 func (c *Canvas) SetBounds(bounds pixel.Rect) {
 	c.gf.SetBounds(bounds)
+	c.setStencilBounds(bounds)
 	if c.sprite == nil {
 		c.sprite = pixel.NewSprite(nil, pixel.Rect{})
 	}
@@ -136,6 +294,27 @@ func (c *Canvas) SetBounds(bounds pixel.Rect) {
 	// c.sprite.SetMatrix(pixel.IM.Moved(c.Bounds().Center()))
 }
 
+// setStencilBounds (re)allocates the stencil renderbuffer backing the even-odd fill rule (see
+// drawEvenOdd) and attaches it to this Canvas's framebuffer, sized to match bounds. glhf.Frame
+// doesn't manage a stencil attachment itself, and GLFrame doesn't expose the framebuffer's raw GL
+// name, so this attaches directly to whatever framebuffer is current while the Canvas's Frame is
+// bound, rather than needing either of those to cooperate.
+func (c *Canvas) setStencilBounds(bounds pixel.Rect) {
+	_, _, w, h := intBounds(bounds)
+	mainthread.Call(func() {
+		if c.stencilRBO == 0 {
+			gl.GenRenderbuffers(1, &c.stencilRBO)
+		}
+		gl.BindRenderbuffer(gl.RENDERBUFFER, c.stencilRBO)
+		gl.RenderbufferStorage(gl.RENDERBUFFER, gl.STENCIL_INDEX8, int32(w), int32(h))
+		gl.BindRenderbuffer(gl.RENDERBUFFER, 0)
+
+		c.gf.Frame().Begin()
+		gl.FramebufferRenderbuffer(gl.FRAMEBUFFER, gl.STENCIL_ATTACHMENT, gl.RENDERBUFFER, c.stencilRBO)
+		c.gf.Frame().End()
+	})
+}
+
 // Bounds returns the rectangular bounds of the Canvas.
 func (c *Canvas) Bounds() pixel.Rect {
 	return c.gf.Bounds()
@@ -159,34 +338,63 @@ func (c *Canvas) setGlhfBounds() {
 	glhf.Bounds(0, 0, bw, bh)
 }
 
-// must be manually called inside mainthread
-func setBlendFunc(cmp pixel.ComposeMethod) {
+// composeBlend returns the Blend preset implementing the given Porter-Duff composition method.
+// Both the RGB and alpha channels use the same factors, matching how these methods have always
+// blended, and GL_FUNC_ADD, the only equation the old single glBlendFunc call could express.
+func composeBlend(cmp pixel.ComposeMethod) pixel.Blend {
+	b := pixel.Blend{
+		EquationRGB:   glhf.FuncAdd,
+		EquationAlpha: glhf.FuncAdd,
+	}
 	switch cmp {
 	case pixel.ComposeOver:
-		glhf.BlendFunc(glhf.One, glhf.OneMinusSrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.One, glhf.OneMinusSrcAlpha
 	case pixel.ComposeIn:
-		glhf.BlendFunc(glhf.DstAlpha, glhf.Zero)
+		b.SrcRGB, b.DstRGB = glhf.DstAlpha, glhf.Zero
 	case pixel.ComposeOut:
-		glhf.BlendFunc(glhf.OneMinusDstAlpha, glhf.Zero)
+		b.SrcRGB, b.DstRGB = glhf.OneMinusDstAlpha, glhf.Zero
 	case pixel.ComposeAtop:
-		glhf.BlendFunc(glhf.DstAlpha, glhf.OneMinusSrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.DstAlpha, glhf.OneMinusSrcAlpha
 	case pixel.ComposeRover:
-		glhf.BlendFunc(glhf.OneMinusDstAlpha, glhf.One)
+		b.SrcRGB, b.DstRGB = glhf.OneMinusDstAlpha, glhf.One
 	case pixel.ComposeRin:
-		glhf.BlendFunc(glhf.Zero, glhf.SrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.Zero, glhf.SrcAlpha
 	case pixel.ComposeRout:
-		glhf.BlendFunc(glhf.Zero, glhf.OneMinusSrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.Zero, glhf.OneMinusSrcAlpha
 	case pixel.ComposeRatop:
-		glhf.BlendFunc(glhf.OneMinusDstAlpha, glhf.SrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.OneMinusDstAlpha, glhf.SrcAlpha
 	case pixel.ComposeXor:
-		glhf.BlendFunc(glhf.OneMinusDstAlpha, glhf.OneMinusSrcAlpha)
+		b.SrcRGB, b.DstRGB = glhf.OneMinusDstAlpha, glhf.OneMinusSrcAlpha
 	case pixel.ComposePlus:
-		glhf.BlendFunc(glhf.One, glhf.One)
+		b.SrcRGB, b.DstRGB = glhf.One, glhf.One
 	case pixel.ComposeCopy:
-		glhf.BlendFunc(glhf.One, glhf.Zero)
+		b.SrcRGB, b.DstRGB = glhf.One, glhf.Zero
 	default:
 		panic(errors.New("Canvas: invalid compose method"))
 	}
+	b.SrcAlpha, b.DstAlpha = b.SrcRGB, b.DstRGB
+	return b
+}
+
+// must be manually called inside mainthread
+//
+// glhf only wraps the single-pair glBlendFunc and has no stencil or multi-unit-texture calls at
+// all, so this package reaches past it to github.com/go-gl/gl/v3.3-core/gl directly for anything
+// glhf doesn't expose, rather than extending glhf itself (which lives in its own repo). This is a
+// deliberate, standing policy, not a one-off: drawEvenOdd's stencil ops and the aux-texture
+// gl.ActiveTexture calls in canvasTriangles.draw do the same for the same reason. glhf.BlendFactor
+// and glhf.BlendEquation are themselves defined as this same gl package's raw enum constants (glhf
+// is built directly on top of it), so the uint32 conversions below reinterpret, not guess, their
+// values.
+func applyBlend(blend pixel.Blend) {
+	gl.BlendFuncSeparate(uint32(blend.SrcRGB), uint32(blend.DstRGB), uint32(blend.SrcAlpha), uint32(blend.DstAlpha))
+	gl.BlendEquationSeparate(uint32(blend.EquationRGB), uint32(blend.EquationAlpha))
+	gl.BlendColor(
+		float32(blend.BlendColor.R),
+		float32(blend.BlendColor.G),
+		float32(blend.BlendColor.B),
+		float32(blend.BlendColor.A),
+	)
 }
 
 // Clear fills the whole Canvas with a single color.
@@ -283,14 +491,18 @@ func (ct *canvasTriangles) draw(tex *glhf.Texture, bounds pixel.Rect) {
 	ct.dst.gf.Dirty()
 
 	// save the current state vars to avoid race condition
-	cmp := ct.dst.cmp
+	blend := ct.dst.blend
 	smt := ct.dst.smooth
 	mat := ct.dst.mat
 	col := ct.dst.col
+	fr := ct.dst.fillRule
+	aux := ct.dst.auxTextures
+	auxBounds := ct.dst.auxBounds
+	numAttachments := ct.dst.numAttachments
 
 	mainthread.CallNonBlock(func() {
 		ct.dst.setGlhfBounds()
-		setBlendFunc(cmp)
+		applyBlend(blend)
 
 		frame := ct.dst.gf.Frame()
 		shader := ct.shader.s
@@ -298,6 +510,17 @@ func (ct *canvasTriangles) draw(tex *glhf.Texture, bounds pixel.Rect) {
 		frame.Begin()
 		shader.Begin()
 
+		if numAttachments > 1 {
+			// Only GL_COLOR_ATTACHMENT0 is written to by default; a custom fragment shader's
+			// outColor1..outColor{n-1} go nowhere until every attachment's draw buffer slot is
+			// enabled.
+			bufs := make([]uint32, numAttachments)
+			for i := range bufs {
+				bufs[i] = gl.COLOR_ATTACHMENT0 + uint32(i)
+			}
+			gl.DrawBuffers(int32(len(bufs)), &bufs[0])
+		}
+
 		ct.shader.uniformDefaults.transform = mat
 		ct.shader.uniformDefaults.colormask = col
 		dstBounds := ct.dst.Bounds()
@@ -316,37 +539,172 @@ func (ct *canvasTriangles) draw(tex *glhf.Texture, bounds pixel.Rect) {
 			float32(bh),
 		}
 
+		for slot := 1; slot < len(aux); slot++ {
+			if aux[slot] == nil {
+				continue
+			}
+			abx, aby, abw, abh := intBounds(auxBounds[slot])
+			ct.shader.uniformDefaults.auxTexbounds[slot] = mgl32.Vec4{
+				float32(abx),
+				float32(aby),
+				float32(abw),
+				float32(abh),
+			}
+			// glhf.Texture.Begin binds to whichever texture unit is active; select the unit it lands on first
+			// (see applyBlend's doc comment for why this reaches past glhf to gl directly).
+			gl.ActiveTexture(gl.TEXTURE0 + uint32(slot))
+			aux[slot].Begin()
+		}
+		gl.ActiveTexture(gl.TEXTURE0)
+
 		for loc, u := range ct.shader.uniforms {
 			ct.shader.s.SetUniformAttr(loc, u.Value())
 		}
 
-		if tex == nil {
-			ct.vs.Begin()
-			ct.vs.Draw()
-			ct.vs.End()
-		} else {
-			tex.Begin()
+		drawVertices := func() {
+			if tex == nil {
+				ct.vs.Begin()
+				ct.vs.Draw()
+				ct.vs.End()
+			} else {
+				tex.Begin()
+
+				if tex.Smooth() != smt {
+					tex.SetSmooth(smt)
+				}
+
+				ct.vs.Begin()
+				ct.vs.Draw()
+				ct.vs.End()
 
-			if tex.Smooth() != smt {
-				tex.SetSmooth(smt)
+				tex.End()
 			}
+		}
 
-			ct.vs.Begin()
-			ct.vs.Draw()
-			ct.vs.End()
+		if fr == pixel.FillRuleEvenOdd {
+			// Keep the picture's texture bound across both stencil passes, so pass 2's covering
+			// rectangle samples it too; drawVertices' own Begin/End pair nests inside this one.
+			if tex != nil {
+				tex.Begin()
+			}
+			ct.drawEvenOdd(drawVertices, ct.destBounds(mat))
+			if tex != nil {
+				tex.End()
+			}
+		} else {
+			drawVertices()
+		}
 
-			tex.End()
+		for slot := 1; slot < len(aux); slot++ {
+			if aux[slot] == nil {
+				continue
+			}
+			gl.ActiveTexture(gl.TEXTURE0 + uint32(slot))
+			aux[slot].End()
 		}
+		gl.ActiveTexture(gl.TEXTURE0)
 
 		shader.End()
 		frame.End()
 	})
 }
 
+// destBounds computes the destination-space (post-transform) axis-aligned bounding box of this
+// triangle list's vertex positions. This is the rectangle pass 2 of the even-odd stencil fill
+// must cover, which is unrelated to bounds/texbounds (the source picture's own texel rect) used
+// elsewhere in draw.
+func (ct *canvasTriangles) destBounds(mat mgl32.Mat3) pixel.Rect {
+	n := ct.Len()
+	if n == 0 {
+		return pixel.Rect{}
+	}
+
+	transform := func(v pixel.Vec) pixel.Vec {
+		r := mat.Mul3x1(mgl32.Vec3{float32(v.X), float32(v.Y), 1})
+		return pixel.V(float64(r[0]), float64(r[1]))
+	}
+
+	rect := pixel.Rect{Min: transform(ct.Position(0)), Max: transform(ct.Position(0))}
+	for i := 1; i < n; i++ {
+		p := transform(ct.Position(i))
+		if p.X < rect.Min.X {
+			rect.Min.X = p.X
+		}
+		if p.Y < rect.Min.Y {
+			rect.Min.Y = p.Y
+		}
+		if p.X > rect.Max.X {
+			rect.Max.X = p.X
+		}
+		if p.Y > rect.Max.Y {
+			rect.Max.Y = p.Y
+		}
+	}
+	return rect
+}
+
+// drawEvenOdd rasterizes the already-bound triangles using a two-pass stencil approach so that
+// concave/self-intersecting polygons fill correctly under the even-odd rule, mirroring desktop GL
+// path renderers.
+//
+// Pass 1 draws the triangles with color writes disabled and stencil op GL_INVERT, flipping bit 0
+// of the stencil buffer for every covered pixel regardless of overlap count. Pass 2 re-enables
+// color writes, tests the stencil for odd coverage (GL_NOTEQUAL, 0, 1), and draws a rectangle
+// covering destBounds (the triangles' destination-space bounding box, not their source texture
+// bounds) using the already-bound color/texture state; the stencil region touched by pass 1 is
+// then cleared back to zero via a scissored glClear. glhf has no stencil calls at all, so this
+// reaches past it to gl directly (see applyBlend's doc comment).
+func (ct *canvasTriangles) drawEvenOdd(drawVertices func(), destBounds pixel.Rect) {
+	bx, by, bw, bh := intBounds(destBounds)
+
+	gl.Enable(gl.STENCIL_TEST)
+	defer gl.Disable(gl.STENCIL_TEST)
+
+	gl.ColorMask(false, false, false, false)
+	gl.StencilMask(0x01)
+	gl.StencilFunc(gl.ALWAYS, 0, 0x01)
+	gl.StencilOp(gl.KEEP, gl.KEEP, gl.INVERT)
+
+	drawVertices()
+
+	gl.ColorMask(true, true, true, true)
+	gl.StencilFunc(gl.NOTEQUAL, 0, 0x01)
+	gl.StencilOp(gl.KEEP, gl.KEEP, gl.KEEP)
+
+	ct.vs.Begin()
+	ct.vs.DrawRect(bx, by, bw, bh)
+	ct.vs.End()
+
+	// The scissor box is in window coordinates, whose origin is the Canvas's Bounds().Min.
+	sx, sy, sw, sh := intBounds(destBounds.Moved(ct.dst.Bounds().Min.Scaled(-1)))
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.Scissor(int32(sx), int32(sy), int32(sw), int32(sh))
+	gl.ClearStencil(0)
+	gl.Clear(gl.STENCIL_BUFFER_BIT)
+	gl.Disable(gl.SCISSOR_TEST)
+}
+
 func (ct *canvasTriangles) Draw() {
 	ct.draw(nil, pixel.Rect{})
 }
 
+// canvasAttachment is a read-only view of one color attachment of a multi-render-target Canvas,
+// usable as a pixel.Picture in later draw passes.
+type canvasAttachment struct {
+	tex    *glhf.Texture
+	bounds pixel.Rect
+}
+
+func (ca *canvasAttachment) Bounds() pixel.Rect {
+	return ca.bounds
+}
+
+func (ca *canvasAttachment) Texture() *glhf.Texture {
+	return ca.tex
+}
+
+var _ GLPicture = (*canvasAttachment)(nil)
+
 type canvasPicture struct {
 	GLPicture
 	dst *Canvas
@@ -0,0 +1,431 @@
+// Package vector implements a 2D vector path builder that can be filled or stroked onto any
+// pixel.Target, such as a pixelgl.Canvas.
+package vector
+
+import (
+	"math"
+
+	"github.com/tango-rocker/pixel"
+)
+
+// DefaultTolerance is the default flattening tolerance used by Path, in target-space pixels. It
+// bounds how far a flattened point may stray from the true curve.
+const DefaultTolerance = 0.5
+
+// FillStyle configures how a Path is rasterized by Fill.
+type FillStyle struct {
+	Color    pixel.RGBA
+	FillRule pixel.FillRule
+}
+
+// Join selects how Stroke joins two consecutive segments.
+type Join int
+
+// Supported stroke joins.
+const (
+	JoinMiter Join = iota
+	JoinBevel
+	JoinRound
+)
+
+// StrokeStyle configures how a Path is rasterized by Stroke.
+type StrokeStyle struct {
+	Color pixel.RGBA
+	Join  Join
+	// MiterLimit bounds how far a JoinMiter join may extend before falling back to a bevel.
+	// Ignored for other joins. Zero means the common default of 4.
+	MiterLimit float64
+}
+
+type segmentKind int
+
+const (
+	segMoveTo segmentKind = iota
+	segLineTo
+	segClose
+)
+
+type segment struct {
+	kind segmentKind
+	to   pixel.Vec
+}
+
+// Path is a vector path builder: a sequence of subpaths made of lines and curves, which can be
+// filled or stroked onto a pixel.Target.
+//
+// Curves are flattened to line segments as they're appended, so Path only ever has to carry
+// straight segments internally: QuadTo and CubicTo adaptively via de Casteljau subdivision, ArcTo
+// in equal angular steps sized so no chord strays further than the tolerance from the arc. The
+// Tolerance field controls how fine that flattening is, in target-space pixels.
+type Path struct {
+	// Tolerance is the maximum distance, in target-space pixels, a flattened curve point may
+	// deviate from the true curve. Smaller values produce smoother curves at the cost of more
+	// triangles. Zero means DefaultTolerance.
+	Tolerance float64
+
+	segments []segment
+	start    pixel.Vec
+	cur      pixel.Vec
+}
+
+// NewPath creates an empty Path starting at the origin.
+func NewPath() *Path {
+	return &Path{Tolerance: DefaultTolerance}
+}
+
+func (p *Path) tolerance() float64 {
+	if p.Tolerance <= 0 {
+		return DefaultTolerance
+	}
+	return p.Tolerance
+}
+
+// MoveTo starts a new subpath at pos, without connecting it to the previous one.
+func (p *Path) MoveTo(pos pixel.Vec) {
+	p.segments = append(p.segments, segment{kind: segMoveTo, to: pos})
+	p.start = pos
+	p.cur = pos
+}
+
+// LineTo appends a straight line from the current point to pos.
+func (p *Path) LineTo(pos pixel.Vec) {
+	p.segments = append(p.segments, segment{kind: segLineTo, to: pos})
+	p.cur = pos
+}
+
+// QuadTo appends a quadratic Bézier curve from the current point through control ctrl to pos,
+// flattened adaptively to straight segments.
+func (p *Path) QuadTo(ctrl, pos pixel.Vec) {
+	p.flattenQuad(p.cur, ctrl, pos, 0)
+	p.cur = pos
+}
+
+// CubicTo appends a cubic Bézier curve from the current point through controls c1 and c2 to pos,
+// flattened adaptively to straight segments.
+func (p *Path) CubicTo(c1, c2, pos pixel.Vec) {
+	p.flattenCubic(p.cur, c1, c2, pos, 0)
+	p.cur = pos
+}
+
+// ArcTo appends a circular arc of the given radius, centered at center, sweeping from startAngle
+// to endAngle (radians, counter-clockwise positive), flattened adaptively to straight segments. A
+// straight segment connects the current point to the arc's start if they differ.
+func (p *Path) ArcTo(center pixel.Vec, radius, startAngle, endAngle float64) {
+	start := center.Add(pixel.Vec{X: radius * math.Cos(startAngle), Y: radius * math.Sin(startAngle)})
+	if p.cur != start {
+		p.LineTo(start)
+	}
+
+	tol := p.tolerance()
+	// Bound the angular step so the flattened chord never strays more than tol from the arc.
+	maxStep := 2 * math.Acos(1-tol/math.Max(radius, tol))
+	if maxStep <= 0 || math.IsNaN(maxStep) {
+		maxStep = math.Pi / 32
+	}
+
+	sweep := endAngle - startAngle
+	steps := int(math.Ceil(math.Abs(sweep) / maxStep))
+	if steps < 1 {
+		steps = 1
+	}
+	for i := 1; i <= steps; i++ {
+		a := startAngle + sweep*float64(i)/float64(steps)
+		p.LineTo(center.Add(pixel.Vec{X: radius * math.Cos(a), Y: radius * math.Sin(a)}))
+	}
+}
+
+// Close closes the current subpath with a straight line back to its starting point.
+func (p *Path) Close() {
+	p.segments = append(p.segments, segment{kind: segClose})
+	p.cur = p.start
+}
+
+func (p *Path) flattenQuad(p0, p1, p2 pixel.Vec, depth int) {
+	if depth >= 24 || quadFlatEnough(p0, p1, p2, p.tolerance()) {
+		p.LineTo(p2)
+		return
+	}
+	p01 := p0.Add(p1).Scaled(0.5)
+	p12 := p1.Add(p2).Scaled(0.5)
+	p012 := p01.Add(p12).Scaled(0.5)
+	p.flattenQuad(p0, p01, p012, depth+1)
+	p.flattenQuad(p012, p12, p2, depth+1)
+}
+
+func (p *Path) flattenCubic(p0, p1, p2, p3 pixel.Vec, depth int) {
+	if depth >= 24 || cubicFlatEnough(p0, p1, p2, p3, p.tolerance()) {
+		p.LineTo(p3)
+		return
+	}
+	p01 := p0.Add(p1).Scaled(0.5)
+	p12 := p1.Add(p2).Scaled(0.5)
+	p23 := p2.Add(p3).Scaled(0.5)
+	p012 := p01.Add(p12).Scaled(0.5)
+	p123 := p12.Add(p23).Scaled(0.5)
+	p0123 := p012.Add(p123).Scaled(0.5)
+	p.flattenCubic(p0, p01, p012, p0123, depth+1)
+	p.flattenCubic(p0123, p123, p23, p3, depth+1)
+}
+
+// quadFlatEnough reports whether the control point p1 is within tol of the chord p0-p2.
+func quadFlatEnough(p0, p1, p2 pixel.Vec, tol float64) bool {
+	return pointLineDistance(p1, p0, p2) <= tol
+}
+
+// cubicFlatEnough reports whether both control points are within tol of the chord p0-p3.
+func cubicFlatEnough(p0, p1, p2, p3 pixel.Vec, tol float64) bool {
+	return pointLineDistance(p1, p0, p3) <= tol && pointLineDistance(p2, p0, p3) <= tol
+}
+
+func pointLineDistance(p, a, b pixel.Vec) float64 {
+	ab := b.Sub(a)
+	length := ab.Len()
+	if length == 0 {
+		return p.Sub(a).Len()
+	}
+	// |ab x ap| / |ab|
+	ap := p.Sub(a)
+	return math.Abs(ab.X*ap.Y-ab.Y*ap.X) / length
+}
+
+// subpaths splits the recorded segments into closed-or-open polylines of flattened points.
+func (p *Path) subpaths() (polys [][]pixel.Vec, closed []bool) {
+	var cur []pixel.Vec
+	var isClosed bool
+	// pen and start track the current point and subpath start, so a line drawn with no MoveTo
+	// before it (from the origin, or from the start point after a Close) keeps its first point.
+	var pen, start pixel.Vec
+	flush := func() {
+		if len(cur) >= 2 {
+			polys = append(polys, cur)
+			closed = append(closed, isClosed)
+		}
+		cur = nil
+		isClosed = false
+	}
+	for _, s := range p.segments {
+		switch s.kind {
+		case segMoveTo:
+			flush()
+			cur = append(cur, s.to)
+			pen, start = s.to, s.to
+		case segLineTo:
+			if len(cur) == 0 {
+				cur = append(cur, pen)
+			}
+			cur = append(cur, s.to)
+			pen = s.to
+		case segClose:
+			isClosed = true
+			flush()
+			pen = start
+		}
+	}
+	flush()
+	return polys, closed
+}
+
+// AppendTrianglesAndIndices appends a fan triangulation of this Path's subpaths to vs and is,
+// suitable for even-odd stencil fill: each subpath is fanned from its own first point, so
+// concave and self-intersecting subpaths still rasterize correctly once drawn with
+// pixel.FillRuleEvenOdd, without Delaunay triangulation.
+func (p *Path) AppendTrianglesAndIndices(vs []pixel.Vertex, is []uint16) ([]pixel.Vertex, []uint16) {
+	polys, _ := p.subpaths()
+	for _, poly := range polys {
+		if len(poly) < 3 {
+			continue
+		}
+		base := uint16(len(vs))
+		for _, v := range poly {
+			vs = append(vs, pixel.Vertex{
+				Position:  v,
+				Color:     pixel.Alpha(1),
+				Intensity: 0,
+			})
+		}
+		for i := 1; i < len(poly)-1; i++ {
+			is = append(is, base, base+uint16(i), base+uint16(i+1))
+		}
+	}
+	return vs, is
+}
+
+// Fill rasterizes the filled interior of this Path onto target, using style's color and fill
+// rule. If target also implements interface{ SetFillRule(pixel.FillRule) } (as pixelgl.Canvas
+// does), Fill installs style.FillRule before drawing; it's the caller's responsibility to set it
+// back afterwards if further draws onto the same target need a different rule.
+func (p *Path) Fill(target pixel.Target, style FillStyle) {
+	if fr, ok := target.(interface{ SetFillRule(pixel.FillRule) }); ok {
+		fr.SetFillRule(style.FillRule)
+	}
+
+	vs, is := p.AppendTrianglesAndIndices(nil, nil)
+	for i := range vs {
+		vs[i].Color = style.Color
+	}
+	draw(target, flatten(vs, is))
+}
+
+// Stroke rasterizes the outline of this Path, width pixels wide, onto target using style's color
+// and join. Each flattened segment is offset by width/2 on either side and joined per
+// style.Join; closed subpaths stroke as a closed ring, open subpaths leave their ends square-cut.
+func (p *Path) Stroke(target pixel.Target, width float64, style StrokeStyle) {
+	polys, closed := p.subpaths()
+
+	var vs []pixel.Vertex
+	var is []uint16
+	for i, poly := range polys {
+		vs, is = appendStrokeTriangles(vs, is, poly, closed[i], width, style)
+	}
+	draw(target, flatten(vs, is))
+}
+
+// flatten expands an indexed vertex/index pair into a flat triangle list (3 consecutive vertices
+// per triangle), the form pixel.TrianglesData and GLTriangles.Draw expect.
+func flatten(vs []pixel.Vertex, is []uint16) []pixel.Vertex {
+	flat := make([]pixel.Vertex, len(is))
+	for i, idx := range is {
+		flat[i] = vs[idx]
+	}
+	return flat
+}
+
+// draw uploads a flat triangle list onto target and draws it with the Canvas's current compose
+// method, fill rule and color mask.
+func draw(target pixel.Target, vs []pixel.Vertex) {
+	td := pixel.MakeTrianglesData(len(vs))
+	for i, v := range vs {
+		(*td)[i] = v
+	}
+
+	bt, ok := target.(pixel.BasicTarget)
+	if !ok {
+		panic("vector: Path: target does not support MakeTriangles")
+	}
+	tt := bt.MakeTriangles(td)
+
+	d, ok := tt.(interface{ Draw() })
+	if !ok {
+		panic("vector: Path: target triangles do not support Draw")
+	}
+	d.Draw()
+}
+
+func appendStrokeTriangles(vs []pixel.Vertex, is []uint16, poly []pixel.Vec, closed bool, width float64, style StrokeStyle) ([]pixel.Vertex, []uint16) {
+	if len(poly) < 2 {
+		return vs, is
+	}
+	hw := width / 2
+	n := len(poly)
+
+	segCount := n - 1
+	if closed {
+		segCount = n
+	}
+
+	// emitQuad appends the quad a0,a1,b0,b1 in perimeter order, matching the is triangle fan
+	// below: (a0,a1,b0) and (a0,b0,b1).
+	emitQuad := func(a0, a1, b0, b1 pixel.Vec) {
+		base := uint16(len(vs))
+		col := style.Color
+		vs = append(vs,
+			pixel.Vertex{Position: a0, Color: col},
+			pixel.Vertex{Position: a1, Color: col},
+			pixel.Vertex{Position: b0, Color: col},
+			pixel.Vertex{Position: b1, Color: col},
+		)
+		is = append(is, base, base+1, base+2, base, base+2, base+3)
+	}
+
+	for i := 0; i < segCount; i++ {
+		a := poly[i]
+		b := poly[(i+1)%n]
+		dir := b.Sub(a)
+		length := dir.Len()
+		if length == 0 {
+			continue
+		}
+		nrm := pixel.Vec{X: -dir.Y / length, Y: dir.X / length}.Scaled(hw)
+		emitQuad(a.Add(nrm), b.Add(nrm), b.Sub(nrm), a.Sub(nrm))
+	}
+
+	joinCount := n - 2
+	if closed {
+		joinCount = n
+	}
+	limit := style.MiterLimit
+	if limit <= 0 {
+		limit = 4
+	}
+	for i := 0; i < joinCount; i++ {
+		var prev, cur, next pixel.Vec
+		if closed {
+			prev, cur, next = poly[(i-1+n)%n], poly[i], poly[(i+1)%n]
+		} else {
+			prev, cur, next = poly[i], poly[i+1], poly[i+2]
+		}
+		appendJoin(emitQuad, prev, cur, next, hw, style.Join, limit)
+	}
+
+	return vs, is
+}
+
+// appendJoin fills the wedge at the outer side of the corner prev-cur-next so two adjacent
+// stroke quads don't leave a gap, per the requested Join style.
+func appendJoin(emitQuad func(a0, a1, b0, b1 pixel.Vec), prev, cur, next pixel.Vec, hw float64, join Join, miterLimit float64) {
+	d0 := cur.Sub(prev)
+	d1 := next.Sub(cur)
+	if d0.Len() == 0 || d1.Len() == 0 {
+		return
+	}
+	n0 := pixel.Vec{X: -d0.Y, Y: d0.X}.Unit().Scaled(hw)
+	n1 := pixel.Vec{X: -d1.Y, Y: d1.X}.Unit().Scaled(hw)
+
+	// n0, n1 are the left-hand perpendiculars of the incoming/outgoing segments, which is the
+	// outer (gap) side of the corner only for a right (clockwise) turn; for a left turn they point
+	// at the already-overlapped inner side instead, so flip them to keep the wedge on the outer
+	// side regardless of turn direction.
+	if cross := d0.X*d1.Y - d0.Y*d1.X; cross > 0 {
+		n0, n1 = n0.Scaled(-1), n1.Scaled(-1)
+	}
+
+	switch join {
+	case JoinRound:
+		const steps = 8
+		a0 := math.Atan2(n0.Y, n0.X)
+		a1 := math.Atan2(n1.Y, n1.X)
+		// Sweep the short way from a0 to a1, so the fan stays on the outer wedge even when the
+		// two angles straddle the +-pi seam of Atan2.
+		da := a1 - a0
+		if da > math.Pi {
+			da -= 2 * math.Pi
+		} else if da < -math.Pi {
+			da += 2 * math.Pi
+		}
+		for s := 0; s < steps; s++ {
+			t0 := a0 + da*float64(s)/steps
+			t1 := a0 + da*float64(s+1)/steps
+			p0 := cur.Add(pixel.Vec{X: hw * math.Cos(t0), Y: hw * math.Sin(t0)})
+			p1 := cur.Add(pixel.Vec{X: hw * math.Cos(t1), Y: hw * math.Sin(t1)})
+			emitQuad(cur, p0, p1, cur)
+		}
+	case JoinMiter:
+		bis := n0.Add(n1)
+		if bis.Len() == 0 {
+			emitQuad(cur, cur.Add(n0), cur.Add(n1), cur)
+			return
+		}
+		bis = bis.Unit()
+		cosHalf := bis.Dot(n0.Unit())
+		if cosHalf <= 1e-6 || 1/cosHalf > miterLimit {
+			emitQuad(cur, cur.Add(n0), cur.Add(n1), cur) // fall back to bevel
+			return
+		}
+		miterLen := hw / cosHalf
+		tip := cur.Add(bis.Scaled(miterLen))
+		emitQuad(cur, cur.Add(n0), tip, cur)
+		emitQuad(cur, tip, cur.Add(n1), cur)
+	default: // JoinBevel
+		emitQuad(cur, cur.Add(n0), cur.Add(n1), cur)
+	}
+}
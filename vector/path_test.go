@@ -0,0 +1,159 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/tango-rocker/pixel"
+)
+
+// triangleArea2 returns twice the signed area of triangle (a, b, c).
+func triangleArea2(a, b, c pixel.Vec) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)
+}
+
+// pointInTriangle reports whether p lies inside (or on the boundary of) triangle (a, b, c),
+// regardless of winding.
+func pointInTriangle(p, a, b, c pixel.Vec) bool {
+	d1 := triangleArea2(p, a, b)
+	d2 := triangleArea2(p, b, c)
+	d3 := triangleArea2(p, c, a)
+	hasNeg := d1 < -1e-9 || d2 < -1e-9 || d3 < -1e-9
+	hasPos := d1 > 1e-9 || d2 > 1e-9 || d3 > 1e-9
+	return !(hasNeg && hasPos)
+}
+
+func TestAppendStrokeTrianglesTilesSegmentRectangle(t *testing.T) {
+	poly := []pixel.Vec{{X: 0, Y: 0}, {X: 10, Y: 0}}
+	style := StrokeStyle{Color: pixel.Alpha(1)}
+
+	vs, is := appendStrokeTriangles(nil, nil, poly, false, 1 /* width */, style)
+	if len(is) != 6 {
+		t.Fatalf("expected a single quad (6 indices), got %d", len(is))
+	}
+
+	tri := func(n int) (pixel.Vec, pixel.Vec, pixel.Vec) {
+		return vs[is[3*n]].Position, vs[is[3*n+1]].Position, vs[is[3*n+2]].Position
+	}
+	a0, a1, a2 := tri(0)
+	b0, b1, b2 := tri(1)
+
+	// Regression test for a bug where the quad's vertex append order didn't match its index
+	// pattern: points well inside the stroked rectangle (away from the a0/b0 diagonal) fell in
+	// neither triangle (a gap), while points near the middle fell in both (a double-blended
+	// overlap).
+	inside := []pixel.Vec{{X: 8, Y: 0.4}, {X: 8, Y: -0.4}, {X: 2, Y: 0.4}, {X: 2, Y: -0.4}, {X: 5, Y: 0}}
+	for _, p := range inside {
+		in0 := pointInTriangle(p, a0, a1, a2)
+		in1 := pointInTriangle(p, b0, b1, b2)
+		if !in0 && !in1 {
+			t.Errorf("point %v falls in neither triangle (gap)", p)
+		}
+	}
+
+	outside := []pixel.Vec{{X: 5, Y: 0.6}, {X: 5, Y: -0.6}, {X: -1, Y: 0}, {X: 11, Y: 0}}
+	for _, p := range outside {
+		in0 := pointInTriangle(p, a0, a1, a2)
+		in1 := pointInTriangle(p, b0, b1, b2)
+		if in0 || in1 {
+			t.Errorf("point %v outside the stroked rectangle falls inside a triangle", p)
+		}
+	}
+
+	gotArea := 0.5*triAbs(a0, a1, a2) + 0.5*triAbs(b0, b1, b2)
+	wantArea := 10.0 * 1.0 // length * width
+	if diff := gotArea - wantArea; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("triangle area = %v, want %v", gotArea, wantArea)
+	}
+}
+
+func triAbs(a, b, c pixel.Vec) float64 {
+	area := triangleArea2(a, b, c)
+	if area < 0 {
+		return -area
+	}
+	return area
+}
+
+// TestAppendJoinFillsOuterCornerBothTurnDirections is a regression test for a bug where the join
+// wedge always used the incoming/outgoing segments' left-hand perpendiculars, which is the outer
+// (gap) side of a corner only for a clockwise turn; a counter-clockwise turn left the true gap
+// unfilled and instead duplicated geometry already covered by the two segment quads.
+func TestAppendJoinFillsOuterCornerBothTurnDirections(t *testing.T) {
+	cases := []struct {
+		name string
+		poly []pixel.Vec
+		gap  pixel.Vec // a point in the outer-corner notch neither segment quad covers
+	}{
+		// (0,0) -> (10,0) -> (10,10): a left (counter-clockwise) turn; the outer notch is
+		// below-right of the corner.
+		{"left turn", []pixel.Vec{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}}, pixel.Vec{X: 10.2, Y: -0.2}},
+		// (0,0) -> (10,0) -> (10,-10): a right (clockwise) turn; the outer notch is above-right
+		// of the corner.
+		{"right turn", []pixel.Vec{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: -10}}, pixel.Vec{X: 10.2, Y: 0.2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			style := StrokeStyle{Color: pixel.Alpha(1), Join: JoinBevel}
+			vs, is := appendStrokeTriangles(nil, nil, c.poly, false, 1 /* width */, style)
+
+			covered := false
+			for i := 0; i+2 < len(is); i += 3 {
+				a := vs[is[i]].Position
+				b := vs[is[i+1]].Position
+				d := vs[is[i+2]].Position
+				if pointInTriangle(c.gap, a, b, d) {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				t.Errorf("outer-corner point %v isn't covered by any emitted triangle", c.gap)
+			}
+		})
+	}
+}
+
+func TestPointLineDistance(t *testing.T) {
+	a := pixel.Vec{X: 0, Y: 0}
+	b := pixel.Vec{X: 10, Y: 0}
+
+	cases := []struct {
+		p    pixel.Vec
+		want float64
+	}{
+		{pixel.Vec{X: 5, Y: 3}, 3},
+		{pixel.Vec{X: 0, Y: 0}, 0},
+		{pixel.Vec{X: -2, Y: 4}, 4},
+	}
+	for _, c := range cases {
+		if got := pointLineDistance(c.p, a, b); got != c.want {
+			t.Errorf("pointLineDistance(%v, %v, %v) = %v, want %v", c.p, a, b, got, c.want)
+		}
+	}
+}
+
+func TestFlattenQuadConvergesToEndpoint(t *testing.T) {
+	p := &Path{Tolerance: 0.01}
+	p.MoveTo(pixel.Vec{X: 0, Y: 0})
+	p.QuadTo(pixel.Vec{X: 5, Y: 10}, pixel.Vec{X: 10, Y: 0})
+
+	polys, _ := p.subpaths()
+	if len(polys) != 1 {
+		t.Fatalf("expected a single subpath, got %d", len(polys))
+	}
+	poly := polys[0]
+	last := poly[len(poly)-1]
+	if last != (pixel.Vec{X: 10, Y: 0}) {
+		t.Errorf("flattened curve doesn't end at the curve's endpoint: got %v", last)
+	}
+
+	// Every flattened point should lie within tolerance of the true quadratic Bézier.
+	for _, v := range poly {
+		// The curve is symmetric and convex; a generous bound on the control-point side
+		// suffices to catch a badly broken subdivision without hand-evaluating the curve.
+		if v.Y < -0.01 || v.Y > 10.01 {
+			t.Errorf("flattened point %v strays outside the curve's control hull", v)
+		}
+	}
+}